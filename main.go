@@ -3,12 +3,18 @@ package main
 import (
 	"bufio"
 	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"net"
+	"net/http"
+	"net/url"
 	"os"
 	"path/filepath"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/slack-go/slack"
@@ -18,21 +24,254 @@ import (
 )
 
 type Config struct {
-	SlackToken     string `json:"slack_token"`
-	AppToken       string `json:"app_token"`      // App-level token for Socket Mode
-	DefaultChannel string `json:"default_channel"`
-	DefaultTimeout int    `json:"default_timeout"` // Default timeout in seconds
+	SlackToken            string   `json:"slack_token"`
+	AppToken              string   `json:"app_token"` // App-level token for Socket Mode
+	DefaultChannel        string   `json:"default_channel"`
+	DefaultTimeout        int      `json:"default_timeout"`         // Default timeout in seconds
+	MaxAttachmentSize     int64    `json:"max_attachment_size"`     // Max size in bytes for --attach uploads (0 means use default)
+	AllowedAttachmentMIME []string `json:"allowed_attachment_mime"` // Allowed MIME type prefixes for --attach uploads (empty means allow all)
 }
 
 var (
-	question string
-	title    string
-	mention  string
-	channel  string
-	threadTS string
-	timeout  int
+	question     string
+	title        string
+	mention      string
+	channel      string
+	threadTS     string
+	timeout      int
+	priority     string
+	contextFile  string
+	contextText  string
+	fields       []string
+	attachments  []string
+	attachStrict bool
+	approvalMode bool
+	output       string
+
+	oauthSetup        bool
+	oauthClientID     string
+	oauthClientSecret string
+
+	ackEmoji    string
+	rejectEmoji string
+	require     string
+
+	requireApprovers int
+	approvers        string
 )
 
+const (
+	exitCodeApprove = 0
+	exitCodeReject  = 2
+	exitCodeChanges = 3
+	exitCodeTimeout = 124
+)
+
+type ApprovalResult struct {
+	Action  string // "approve", "reject", or "changes"
+	User    string
+	Comment string
+}
+
+const (
+	outputText  = "text"
+	outputJSON  = "json"
+	outputJSONL = "jsonl"
+)
+
+type replyInfo struct {
+	UserID      string `json:"user_id"`
+	Username    string `json:"username"`
+	DisplayName string `json:"display_name"`
+	Text        string `json:"text"`
+	TS          string `json:"ts"`
+	ThreadTS    string `json:"thread_ts"`
+	Permalink   string `json:"permalink,omitempty"`
+}
+
+type attachmentInfo struct {
+	Path      string `json:"path"`
+	Permalink string `json:"permalink,omitempty"`
+	Error     string `json:"error,omitempty"`
+}
+
+type approvalInfo struct {
+	Action      string `json:"action"`
+	User        string `json:"user"`
+	DisplayName string `json:"display_name"`
+	Comment     string `json:"comment,omitempty"`
+}
+
+type askDocument struct {
+	ChannelID   string           `json:"channel_id"`
+	ThreadTS    string           `json:"thread_ts"`
+	QuestionTS  string           `json:"question_ts"`
+	Status      string           `json:"status"` // replied, timeout, approved, rejected, changes_requested; failures return a command error and set no status
+	Attachments []attachmentInfo `json:"attachments,omitempty"`
+	Replies     []*replyInfo     `json:"replies,omitempty"`
+	Approval    *approvalInfo    `json:"approval,omitempty"`
+}
+
+type eventEmitter interface {
+	messagePosted(timestamp string)
+	posted(channelID, threadTS, questionTS string)
+	attachmentUploaded(path, permalink string)
+	attachmentFailed(path string, err error)
+	waiting(timeoutSeconds int)
+	finish(replies []*replyInfo, status string)
+	approvalFinish(approval *approvalInfo, status string)
+}
+
+func newEventEmitter(output string) (eventEmitter, error) {
+	switch output {
+	case "", outputText:
+		return textEmitter{}, nil
+	case outputJSON:
+		return &jsonEmitter{}, nil
+	case outputJSONL:
+		return &jsonEmitter{streaming: true}, nil
+	default:
+		return nil, fmt.Errorf("invalid --output value %q (want %q, %q, or %q)", output, outputText, outputJSON, outputJSONL)
+	}
+}
+
+type textEmitter struct{}
+
+func (textEmitter) messagePosted(timestamp string) {
+	fmt.Printf("Message posted successfully. Timestamp: %s\n", timestamp)
+}
+
+func (textEmitter) posted(channelID, threadTS, questionTS string) {}
+
+func (textEmitter) attachmentUploaded(path, permalink string) {
+	fmt.Printf("Uploaded attachment: %s -> %s\n", path, permalink)
+}
+
+func (textEmitter) attachmentFailed(path string, err error) {
+	fmt.Fprintf(os.Stderr, "Warning: failed to upload attachment %s: %v\n", path, err)
+}
+
+func (textEmitter) waiting(timeoutSeconds int) {
+	fmt.Printf("Waiting for reply (timeout: %d seconds)...\n", timeoutSeconds)
+}
+
+func (textEmitter) finish(replies []*replyInfo, status string) {
+	if len(replies) == 0 {
+		fmt.Println("Timeout: No reply received.")
+		return
+	}
+
+	for _, reply := range replies {
+		fmt.Println("\nReply received:")
+		fmt.Printf("From: %s\n", reply.DisplayName)
+		fmt.Printf("Text: %s\n", reply.Text)
+		fmt.Printf("Thread TS: %s\n", reply.ThreadTS)
+
+		if ts, err := parseSlackTimestamp(reply.TS); err == nil {
+			fmt.Printf("Timestamp: %s\n", ts.Format("2006-01-02 15:04"))
+		} else {
+			fmt.Printf("Timestamp: %s\n", reply.TS)
+		}
+	}
+}
+
+func (textEmitter) approvalFinish(approval *approvalInfo, status string) {
+	if approval == nil {
+		fmt.Println("Timeout: No decision received.")
+		return
+	}
+
+	fmt.Println("\nDecision received:")
+	fmt.Printf("From: %s\n", approval.DisplayName)
+	fmt.Printf("Action: %s\n", approval.Action)
+	if approval.Comment != "" {
+		fmt.Printf("Comment: %s\n", approval.Comment)
+	}
+}
+
+type jsonEmitter struct {
+	streaming bool
+	doc       askDocument
+}
+
+func (e *jsonEmitter) messagePosted(timestamp string) {}
+
+func (e *jsonEmitter) posted(channelID, threadTS, questionTS string) {
+	e.doc.ChannelID = channelID
+	e.doc.ThreadTS = threadTS
+	e.doc.QuestionTS = questionTS
+	if e.streaming {
+		e.emit("posted")
+	}
+}
+
+func (e *jsonEmitter) waiting(timeoutSeconds int) {
+	if e.streaming {
+		e.emit("waiting")
+	}
+}
+
+func (e *jsonEmitter) attachmentUploaded(path, permalink string) {
+	e.doc.Attachments = append(e.doc.Attachments, attachmentInfo{Path: path, Permalink: permalink})
+	if e.streaming {
+		e.emit("attachment_uploaded")
+	}
+}
+
+func (e *jsonEmitter) attachmentFailed(path string, err error) {
+	e.doc.Attachments = append(e.doc.Attachments, attachmentInfo{Path: path, Error: err.Error()})
+	if e.streaming {
+		e.emit("attachment_failed")
+	}
+}
+
+func (e *jsonEmitter) finish(replies []*replyInfo, status string) {
+	e.doc.Replies = replies
+	e.doc.Status = status
+
+	if !e.streaming {
+		e.printDoc()
+		return
+	}
+
+	e.emit("reply")
+	e.emit("status_posted")
+}
+
+func (e *jsonEmitter) approvalFinish(approval *approvalInfo, status string) {
+	e.doc.Approval = approval
+	e.doc.Status = status
+
+	if !e.streaming {
+		e.printDoc()
+		return
+	}
+
+	e.emit("approval")
+	e.emit("status_posted")
+}
+
+func (e *jsonEmitter) printDoc() {
+	data, err := json.Marshal(e.doc)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to marshal output: %v\n", err)
+		return
+	}
+	fmt.Println(string(data))
+}
+
+func (e *jsonEmitter) emit(event string) {
+	data, err := json.Marshal(struct {
+		Event string `json:"event"`
+		askDocument
+	}{Event: event, askDocument: e.doc})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to marshal output: %v\n", err)
+		return
+	}
+	fmt.Println(string(data))
+}
+
 func main() {
 	var rootCmd = &cobra.Command{
 		Use:   "ask-human-cli",
@@ -55,6 +294,19 @@ func main() {
 	askCmd.Flags().StringVar(&channel, "channel", "", "Channel to post to")
 	askCmd.Flags().StringVar(&threadTS, "thread-ts", "", "Thread timestamp for replies")
 	askCmd.Flags().IntVar(&timeout, "timeout", 0, "Timeout in seconds (0 means use default from config)")
+	askCmd.Flags().StringVar(&priority, "priority", "", "Priority level for the question (low, medium, high) - colors the Slack message sidebar")
+	askCmd.Flags().StringVar(&contextFile, "context-file", "", "Path to a file whose contents are inlined as a code block in the question")
+	askCmd.Flags().StringVar(&contextText, "context", "", "Inline context text included as a code block in the question")
+	askCmd.Flags().StringArrayVar(&fields, "field", nil, "Additional key=value field to attach to the question (repeatable)")
+	askCmd.Flags().StringArrayVar(&attachments, "attach", nil, "Local file path to upload into the question thread (repeatable)")
+	askCmd.Flags().BoolVar(&attachStrict, "attach-strict", false, "Fail the command if any --attach upload fails (default: warn and continue)")
+	askCmd.Flags().BoolVar(&approvalMode, "approval", false, "Post Approve/Reject/Needs changes buttons instead of waiting for a text reply")
+	askCmd.Flags().StringVar(&output, "output", outputText, "Output format: text, json, or jsonl")
+	askCmd.Flags().StringVar(&ackEmoji, "ack-emoji", "", "Comma-separated emoji names that count as an approving reaction on the question message (e.g. ok,ship_it)")
+	askCmd.Flags().StringVar(&rejectEmoji, "reject-emoji", "", "Comma-separated emoji names that count as a rejecting reaction on the question message (e.g. no_entry)")
+	askCmd.Flags().StringVar(&require, "require", "reply", "Which signal terminates the wait: reply, reaction, or any")
+	askCmd.Flags().IntVar(&requireApprovers, "require-approvers", 1, "Number of distinct approvers that must respond before returning")
+	askCmd.Flags().StringVar(&approvers, "approvers", "", "Comma-separated list of @username/@displayname allowed to satisfy --require-approvers (empty allows anyone)")
 
 	askCmd.MarkFlagRequired("question")
 
@@ -65,6 +317,10 @@ func main() {
 		RunE:  runSetup,
 	}
 
+	setupCmd.Flags().BoolVar(&oauthSetup, "oauth", false, "Authorize the Slack bot token via OAuth instead of pasting it manually")
+	setupCmd.Flags().StringVar(&oauthClientID, "client-id", "", "Slack app client ID for --oauth (defaults to SLACK_CLIENT_ID)")
+	setupCmd.Flags().StringVar(&oauthClientSecret, "client-secret", "", "Slack app client secret for --oauth (defaults to SLACK_CLIENT_SECRET)")
+
 	var descriptionCmd = &cobra.Command{
 		Use:   "description",
 		Short: "Show usage description",
@@ -86,6 +342,17 @@ func runAsk(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("--title is required when --thread-ts is not specified")
 	}
 
+	emitter, err := newEventEmitter(output)
+	if err != nil {
+		return err
+	}
+
+	switch require {
+	case "reply", "reaction", "any":
+	default:
+		return fmt.Errorf("invalid --require value %q (want reply, reaction, or any)", require)
+	}
+
 	config, err := loadConfig()
 	if err != nil {
 		return fmt.Errorf("failed to load config: %w", err)
@@ -107,7 +374,7 @@ func runAsk(cmd *cobra.Command, args []string) error {
 	}
 
 	api := slack.New(slackToken, slack.OptionAppLevelToken(appToken))
-	
+
 	client := socketmode.New(
 		api,
 		socketmode.OptionDebug(false),
@@ -135,13 +402,13 @@ func runAsk(cmd *cobra.Command, args []string) error {
 		if !strings.HasPrefix(mention, "@") {
 			return fmt.Errorf("mention must start with @ (e.g., @hogelog)")
 		}
-		
+
 		username := strings.TrimPrefix(mention, "@")
 		users, err := api.GetUsers()
 		if err != nil {
 			return fmt.Errorf("failed to get users for mention resolution: %w", err)
 		}
-		
+
 		found := false
 		for _, user := range users {
 			if user.Name == username || user.Profile.DisplayName == username {
@@ -150,30 +417,53 @@ func runAsk(cmd *cobra.Command, args []string) error {
 				break
 			}
 		}
-		
+
 		if !found {
 			return fmt.Errorf("user @%s not found", username)
 		}
 	}
 
+	quorum := requireApprovers
+	if quorum < 1 {
+		quorum = 1
+	}
+
+	var approverIDs map[string]struct{}
+	if !approvalMode {
+		approverIDs, err = resolveApproverIDs(api, approvers)
+		if err != nil {
+			return fmt.Errorf("failed to resolve approvers: %w", err)
+		}
+
+		if approverIDs != nil && len(approverIDs) < quorum {
+			return fmt.Errorf("--require-approvers %d exceeds the %d distinct --approvers given; quorum can never be reached", quorum, len(approverIDs))
+		}
+	}
+
 	if threadTS == "" {
 		var message = "📝 " + title
 
-	  options := []slack.MsgOption{
-	  	slack.MsgOptionText(message, false),
-	  	slack.MsgOptionAsUser(false),
-	  }
+		options := []slack.MsgOption{
+			slack.MsgOptionText(message, false),
+			slack.MsgOptionAsUser(false),
+		}
 
-	  _, timestamp, err := api.PostMessage(channelID, options...)
-	  if err != nil {
-	  	return fmt.Errorf("failed to post message: %w", err)
-	  }
+		_, timestamp, err := api.PostMessage(channelID, options...)
+		if err != nil {
+			return fmt.Errorf("failed to post message: %w", err)
+		}
 
-	  fmt.Printf("Message posted successfully. Timestamp: %s\n", timestamp)
+		emitter.messagePosted(timestamp)
 
 		threadTS = timestamp
 	}
 
+	if len(attachments) > 0 {
+		if err := uploadAttachments(api, channelID, threadTS, attachments, getMaxAttachmentSize(config), config.AllowedAttachmentMIME, attachStrict, emitter); err != nil {
+			return fmt.Errorf("failed to upload attachments: %w", err)
+		}
+	}
+
 	var sb strings.Builder
 	if userID != "" {
 		sb.WriteString("<@" + userID + "> ")
@@ -181,68 +471,91 @@ func runAsk(cmd *cobra.Command, args []string) error {
 	sb.WriteString(question)
 	message := sb.String()
 
-	instructionOptions := []slack.MsgOption{
-		slack.MsgOptionText(message, false),
-		slack.MsgOptionAsUser(false),
-		slack.MsgOptionTS(threadTS),
+	questionContext, err := resolveContext()
+	if err != nil {
+		return fmt.Errorf("failed to resolve context: %w", err)
+	}
+
+	var instructionOptions []slack.MsgOption
+	if approvalMode {
+		instructionOptions = buildApprovalBlocks(message, threadTS)
+	} else if priority != "" || questionContext != "" || len(fields) > 0 {
+		blocks, err := buildQuestionBlocks(title, message, priority, questionContext, fields)
+		if err != nil {
+			return fmt.Errorf("failed to build question message: %w", err)
+		}
+		instructionOptions = append(blocks, slack.MsgOptionTS(threadTS))
+	} else {
+		instructionOptions = []slack.MsgOption{
+			slack.MsgOptionText(message, false),
+			slack.MsgOptionAsUser(false),
+			slack.MsgOptionTS(threadTS),
+		}
 	}
-	
-	_, _, err = api.PostMessage(channelID, instructionOptions...)
+
+	questionTS, _, err := api.PostMessage(channelID, instructionOptions...)
 	if err != nil {
 		return fmt.Errorf("failed to post instruction message: %w", err)
 	}
 
+	emitter.posted(channelID, threadTS, questionTS)
+
 	waitTimeout := getTimeout(config)
 	if waitTimeout <= 0 {
 		waitTimeout = 300
 	}
 
-	fmt.Printf("Waiting for reply (timeout: %d seconds)...\n", waitTimeout)
+	emitter.waiting(waitTimeout)
 
 	waitThreadTS := threadTS
-	reply, err := waitForReplySocketMode(client, api, channelID, waitThreadTS, waitTimeout)
+
+	if approvalMode {
+		runApprovalWait(client, api, channelID, waitThreadTS, waitTimeout, cancel, emitter)
+		return nil
+	}
+
+	replies, err := waitForRepliesSocketMode(client, api, channelID, waitThreadTS, questionTS, waitTimeout, ackEmoji, rejectEmoji, require, quorum, approverIDs)
 	if err != nil {
 		return fmt.Errorf("error waiting for reply: %w", err)
 	}
 
+	repliesForOutput := make([]*replyInfo, 0, len(replies))
+	for _, reply := range replies {
+		repliesForOutput = append(repliesForOutput, buildReplyInfo(api, channelID, reply))
+	}
+
+	status := "timeout"
+	if len(replies) >= quorum {
+		status = "replied"
+	}
+
 	var responseMessage string
-	
-	if reply == nil {
-		fmt.Println("Timeout: No reply received.")
+	switch {
+	case len(repliesForOutput) == 0:
 		responseMessage = "⏱️ Timed out waiting for response."
-	} else {
-		userInfo, err := api.GetUserInfo(reply.User)
-		var fromDisplay string
-		if err == nil {
-			if userInfo.Profile.DisplayName != "" {
-				fromDisplay = "@" + userInfo.Profile.DisplayName
-			} else {
-				fromDisplay = "@" + userInfo.Name
-			}
+	case quorum <= 1:
+		responseMessage = fmt.Sprintf("✅ Response received from %s", repliesForOutput[0].DisplayName)
+	default:
+		var sb strings.Builder
+		if status == "replied" {
+			fmt.Fprintf(&sb, "✅ Quorum reached (%d/%d approvers):\n", len(repliesForOutput), quorum)
 		} else {
-			fromDisplay = reply.User
+			fmt.Fprintf(&sb, "⏱️ Timed out with %d/%d approvers:\n", len(repliesForOutput), quorum)
 		}
-		
-		fmt.Println("\nReply received:")
-		fmt.Printf("From: %s\n", fromDisplay)
-		fmt.Printf("Text: %s\n", reply.Text)
-		fmt.Printf("Thread TS: %s\n", reply.ThreadTimestamp)
-		
-		if ts, err := parseSlackTimestamp(reply.Timestamp); err == nil {
-			fmt.Printf("Timestamp: %s\n", ts.Format("2006-01-02 15:04"))
-		} else {
-			fmt.Printf("Timestamp: %s\n", reply.Timestamp)
+		for _, r := range repliesForOutput {
+			fmt.Fprintf(&sb, "- %s: %s\n", r.DisplayName, r.Text)
 		}
-		
-		responseMessage = fmt.Sprintf("✅ Response received from %s", fromDisplay)
+		responseMessage = strings.TrimRight(sb.String(), "\n")
 	}
-	
+
+	emitter.finish(repliesForOutput, status)
+
 	responseOptions := []slack.MsgOption{
 		slack.MsgOptionText(responseMessage, false),
 		slack.MsgOptionAsUser(false),
 		slack.MsgOptionTS(threadTS),
 	}
-	
+
 	_, _, err = api.PostMessage(channelID, responseOptions...)
 	if err != nil {
 		fmt.Printf("Warning: Failed to post response status: %v\n", err)
@@ -260,7 +573,7 @@ func loadConfig() (*Config, error) {
 	}
 
 	configPath := filepath.Join(home, ".config", "ask-human-cli", "config.json")
-	
+
 	data, err := os.ReadFile(configPath)
 	if err != nil {
 		if os.IsNotExist(err) {
@@ -298,11 +611,135 @@ func getChannel(config *Config) string {
 	return config.DefaultChannel
 }
 
+func resolveContext() (string, error) {
+	if contextFile != "" {
+		data, err := os.ReadFile(contextFile)
+		if err != nil {
+			return "", err
+		}
+		return string(data), nil
+	}
+	return contextText, nil
+}
+
+func resolveApproverIDs(api *slack.Client, raw string) (map[string]struct{}, error) {
+	if raw == "" {
+		return nil, nil
+	}
+
+	users, err := api.GetUsers()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get users for approver resolution: %w", err)
+	}
+
+	allowed := make(map[string]struct{})
+	for _, entry := range strings.Split(raw, ",") {
+		name := strings.TrimSpace(entry)
+		if name == "" {
+			continue
+		}
+		if !strings.HasPrefix(name, "@") {
+			return nil, fmt.Errorf("approver %q must start with @ (e.g., @hogelog)", name)
+		}
+		name = strings.TrimPrefix(name, "@")
+
+		id, found := matchApprover(users, name)
+		if !found {
+			return nil, fmt.Errorf("approver @%s not found", name)
+		}
+		allowed[id] = struct{}{}
+	}
+
+	return allowed, nil
+}
+
+func matchApprover(users []slack.User, name string) (string, bool) {
+	for _, user := range users {
+		if user.Name == name || user.Profile.DisplayName == name {
+			return user.ID, true
+		}
+	}
+	return "", false
+}
+
+func buildReplyInfo(api *slack.Client, channelID string, reply *slack.Message) *replyInfo {
+	userInfo, err := api.GetUserInfo(reply.User)
+	var username, fromDisplay string
+	if err == nil {
+		username = userInfo.Name
+		if userInfo.Profile.DisplayName != "" {
+			fromDisplay = "@" + userInfo.Profile.DisplayName
+		} else {
+			fromDisplay = "@" + userInfo.Name
+		}
+	} else {
+		fromDisplay = reply.User
+	}
+
+	permalink, _ := api.GetPermalink(&slack.PermalinkParameters{Channel: channelID, Ts: reply.Timestamp})
+
+	return &replyInfo{
+		UserID:      reply.User,
+		Username:    username,
+		DisplayName: fromDisplay,
+		Text:        reply.Text,
+		TS:          reply.Timestamp,
+		ThreadTS:    reply.ThreadTimestamp,
+		Permalink:   permalink,
+	}
+}
+
+func buildQuestionBlocks(title, message, priority, context string, rawFields []string) ([]slack.MsgOption, error) {
+	attachment := slack.Attachment{
+		Color: priorityColor(priority),
+		Title: title,
+		Text:  message,
+	}
+
+	if context != "" {
+		attachment.Fields = append(attachment.Fields, slack.AttachmentField{
+			Title: "Context",
+			Value: "```\n" + context + "\n```",
+			Short: false,
+		})
+	}
+
+	for _, raw := range rawFields {
+		key, value, ok := strings.Cut(raw, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid --field value %q, expected key=value", raw)
+		}
+		attachment.Fields = append(attachment.Fields, slack.AttachmentField{
+			Title: key,
+			Value: value,
+			Short: len(value) <= 40,
+		})
+	}
+
+	return []slack.MsgOption{
+		slack.MsgOptionAttachments(attachment),
+		slack.MsgOptionAsUser(false),
+	}, nil
+}
+
+func priorityColor(priority string) string {
+	switch strings.ToLower(priority) {
+	case "red", "high", "urgent":
+		return "danger"
+	case "yellow", "medium", "warning":
+		return "warning"
+	case "green", "low":
+		return "good"
+	default:
+		return ""
+	}
+}
+
 func resolveChannelID(api *slack.Client, channelName string) (string, error) {
 	if !strings.HasPrefix(channelName, "#") {
 		channelName = "#" + channelName
 	}
-	
+
 	channels, _, err := api.GetConversations(&slack.GetConversationsParameters{
 		Types: []string{"public_channel", "private_channel"},
 	})
@@ -330,21 +767,171 @@ func getTimeout(config *Config) int {
 	return 300 // Default 5 minutes
 }
 
+const defaultMaxAttachmentSize = 10 * 1024 * 1024 // 10 MiB
+
+func getMaxAttachmentSize(config *Config) int64 {
+	if config.MaxAttachmentSize > 0 {
+		return config.MaxAttachmentSize
+	}
+	return defaultMaxAttachmentSize
+}
+
+func uploadAttachments(api *slack.Client, channelID, threadTS string, paths []string, maxSize int64, allowedMIME []string, strict bool, emitter eventEmitter) error {
+	for _, path := range paths {
+		if err := uploadAttachment(api, channelID, threadTS, path, maxSize, allowedMIME, emitter); err != nil {
+			if strict {
+				return fmt.Errorf("%s: %w", path, err)
+			}
+			emitter.attachmentFailed(path, err)
+			continue
+		}
+	}
+	return nil
+}
+
+func uploadAttachment(api *slack.Client, channelID, threadTS, path string, maxSize int64, allowedMIME []string, emitter eventEmitter) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	if info.Size() > maxSize {
+		return fmt.Errorf("file size %d exceeds max attachment size %d", info.Size(), maxSize)
+	}
+
+	if len(allowedMIME) > 0 {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		mimeType := http.DetectContentType(data)
+		allowed := false
+		for _, prefix := range allowedMIME {
+			if strings.HasPrefix(mimeType, prefix) {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return fmt.Errorf("mime type %s is not in the allowed list", mimeType)
+		}
+	}
+
+	summary, err := api.UploadFileV2(slack.UploadFileV2Parameters{
+		File:            path,
+		Filename:        filepath.Base(path),
+		FileSize:        int(info.Size()),
+		Channel:         channelID,
+		ThreadTimestamp: threadTS,
+	})
+	if err != nil {
+		return err
+	}
+
+	var permalink string
+	if file, _, _, err := api.GetFileInfo(summary.ID, 0, 0); err == nil {
+		permalink = file.Permalink
+	}
+
+	emitter.attachmentUploaded(path, permalink)
+	return nil
+}
+
+func splitEmojiList(raw string) map[string]struct{} {
+	set := make(map[string]struct{})
+	for _, name := range strings.Split(raw, ",") {
+		name = strings.Trim(strings.TrimSpace(name), ":")
+		if name != "" {
+			set[name] = struct{}{}
+		}
+	}
+	return set
+}
+
+func reactionMessage(ev *slackevents.ReactionAddedEvent, decision string) *slack.Message {
+	return &slack.Message{
+		Msg: slack.Msg{
+			Type:      "reaction_added",
+			Channel:   ev.Item.Channel,
+			User:      ev.User,
+			Text:      fmt.Sprintf(":%s: (%s)", ev.Reaction, decision),
+			Timestamp: ev.Item.Timestamp,
+		},
+	}
+}
+
+type approverReplies struct {
+	mu      sync.Mutex
+	order   []string
+	replies map[string]*slack.Message
+}
+
+func newApproverReplies() *approverReplies {
+	return &approverReplies{replies: make(map[string]*slack.Message)}
+}
+
+func (a *approverReplies) add(userID string, msg *slack.Message) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if _, exists := a.replies[userID]; exists {
+		return false
+	}
+	a.replies[userID] = msg
+	a.order = append(a.order, userID)
+	return true
+}
+
+func (a *approverReplies) len() int {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return len(a.order)
+}
+
+func (a *approverReplies) messages() []*slack.Message {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	msgs := make([]*slack.Message, 0, len(a.order))
+	for _, userID := range a.order {
+		msgs = append(msgs, a.replies[userID])
+	}
+	return msgs
+}
+
+func recordApproverReply(replies *approverReplies, allowedApprovers map[string]struct{}, quorum int, userID string, msg *slack.Message) bool {
+	if allowedApprovers != nil {
+		if _, ok := allowedApprovers[userID]; !ok {
+			return false
+		}
+	}
+	replies.add(userID, msg)
+	return replies.len() >= quorum
+}
 
-func waitForReplySocketMode(client *socketmode.Client, api *slack.Client, channelID, threadTS string, timeoutSeconds int) (*slack.Message, error) {
+func waitForRepliesSocketMode(client *socketmode.Client, api *slack.Client, channelID, threadTS, questionTS string, timeoutSeconds int, ackEmoji, rejectEmoji, require string, quorum int, allowedApprovers map[string]struct{}) ([]*slack.Message, error) {
 	deadline := time.Now().Add(time.Duration(timeoutSeconds) * time.Second)
-	
+
 	authTest, err := api.AuthTest()
 	if err != nil {
 		return nil, fmt.Errorf("failed to get bot user ID: %w", err)
 	}
 	botUserID := authTest.UserID
-	
-	eventCh := make(chan *slack.Message, 1)
-	
+
+	wantReply := require == "reply" || require == "any"
+	wantReaction := require == "reaction" || require == "any"
+	ackSet := splitEmojiList(ackEmoji)
+	rejectSet := splitEmojiList(rejectEmoji)
+
+	replies := newApproverReplies()
+	resultCh := make(chan []*slack.Message, 1)
+
 	ctx, cancel := context.WithDeadline(context.Background(), deadline)
 	defer cancel()
-	
+
+	record := func(userID string, msg *slack.Message) bool {
+		return recordApproverReply(replies, allowedApprovers, quorum, userID, msg)
+	}
+
 	go func() {
 		for {
 			select {
@@ -357,58 +944,267 @@ func waitForReplySocketMode(client *socketmode.Client, api *slack.Client, channe
 					if !ok {
 						continue
 					}
-					
+
 					switch eventsAPIEvent.Type {
 					case slackevents.CallbackEvent:
 						innerEvent := eventsAPIEvent.InnerEvent
-						
+
 						switch ev := innerEvent.Data.(type) {
 						case *slackevents.MessageEvent:
-							if ev.ThreadTimeStamp == threadTS && ev.Channel == channelID {
+							if wantReply && ev.ThreadTimeStamp == threadTS && ev.Channel == channelID {
 								if ev.User != botUserID && ev.SubType != "bot_message" {
 									msg := &slack.Message{
 										Msg: slack.Msg{
-											Type:      ev.Type,
-											Channel:   ev.Channel,
-											User:      ev.User,
-											Text:      ev.Text,
-											Timestamp: ev.TimeStamp,
+											Type:            ev.Type,
+											Channel:         ev.Channel,
+											User:            ev.User,
+											Text:            ev.Text,
+											Timestamp:       ev.TimeStamp,
 											ThreadTimestamp: ev.ThreadTimeStamp,
 										},
 									}
-									eventCh <- msg
+									if record(ev.User, msg) {
+										client.Ack(*evt.Request)
+										resultCh <- replies.messages()
+										return
+									}
+								}
+							}
+						case *slackevents.ReactionAddedEvent:
+							if wantReaction && ev.Item.Timestamp == questionTS && ev.Item.Channel == channelID {
+								decision := ""
+								if _, ok := ackSet[ev.Reaction]; ok {
+									decision = "ack"
+								} else if _, ok := rejectSet[ev.Reaction]; ok {
+									decision = "reject"
+								}
+								if decision != "" && record(ev.User, reactionMessage(ev, decision)) {
 									client.Ack(*evt.Request)
+									resultCh <- replies.messages()
 									return
 								}
 							}
 						}
 					}
-					
+
 					client.Ack(*evt.Request)
 				}
 			}
 		}
 	}()
-	
+
 	select {
-	case msg := <-eventCh:
-		return msg, nil
+	case msgs := <-resultCh:
+		return msgs, nil
+	case <-ctx.Done():
+		return replies.messages(), nil
+	}
+}
+
+func approvalBlockID(threadTS string) string {
+	return "ask-human-approval-" + threadTS
+}
+
+func buildApprovalBlocks(message, threadTS string) []slack.MsgOption {
+	section := slack.NewSectionBlock(
+		slack.NewTextBlockObject(slack.MarkdownType, message, false, false),
+		nil, nil,
+	)
+
+	approveBtn := slack.NewButtonBlockElement("approve", threadTS, slack.NewTextBlockObject(slack.PlainTextType, "Approve", true, false))
+	approveBtn.Style = slack.StylePrimary
+	rejectBtn := slack.NewButtonBlockElement("reject", threadTS, slack.NewTextBlockObject(slack.PlainTextType, "Reject", true, false))
+	rejectBtn.Style = slack.StyleDanger
+	changesBtn := slack.NewButtonBlockElement("changes", threadTS, slack.NewTextBlockObject(slack.PlainTextType, "Needs changes", true, false))
+
+	actions := slack.NewActionBlock(approvalBlockID(threadTS), approveBtn, rejectBtn, changesBtn)
+
+	return []slack.MsgOption{
+		slack.MsgOptionBlocks(section, actions),
+		slack.MsgOptionTS(threadTS),
+	}
+}
+
+func buildCommentModal(action, threadTS string) slack.ModalViewRequest {
+	input := slack.NewPlainTextInputBlockElement(slack.NewTextBlockObject(slack.PlainTextType, "Optional comment", true, false), "comment_input")
+	input.Multiline = true
+	commentBlock := slack.NewInputBlock("comment_block", slack.NewTextBlockObject(slack.PlainTextType, "Comment", true, false), nil, input)
+	commentBlock.Optional = true
+
+	return slack.ModalViewRequest{
+		Type:            slack.VTModal,
+		CallbackID:      "ask-human-comment",
+		PrivateMetadata: action + "|" + threadTS,
+		Title:           slack.NewTextBlockObject(slack.PlainTextType, "Add a comment", false, false),
+		Submit:          slack.NewTextBlockObject(slack.PlainTextType, "Submit", false, false),
+		Close:           slack.NewTextBlockObject(slack.PlainTextType, "Cancel", false, false),
+		Blocks: slack.Blocks{
+			BlockSet: []slack.Block{commentBlock},
+		},
+	}
+}
+
+func waitForInteractionSocketMode(client *socketmode.Client, api *slack.Client, channelID, threadTS string, timeoutSeconds int) (*ApprovalResult, error) {
+	deadline := time.Now().Add(time.Duration(timeoutSeconds) * time.Second)
+	blockID := approvalBlockID(threadTS)
+
+	resultCh := make(chan *ApprovalResult, 1)
+
+	ctx, cancel := context.WithDeadline(context.Background(), deadline)
+	defer cancel()
+
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case evt := <-client.Events:
+				if evt.Type != socketmode.EventTypeInteractive {
+					continue
+				}
+
+				callback, ok := evt.Data.(slack.InteractionCallback)
+				if !ok {
+					continue
+				}
+
+				switch callback.Type {
+				case slack.InteractionTypeBlockActions:
+					handled := false
+					for _, action := range callback.ActionCallback.BlockActions {
+						if action.BlockID != blockID || action.Value != threadTS {
+							continue
+						}
+						handled = true
+
+						if action.ActionID == "approve" {
+							client.Ack(*evt.Request)
+							resultCh <- &ApprovalResult{Action: "approve", User: callback.User.ID}
+							return
+						}
+
+						client.Ack(*evt.Request)
+						modal := buildCommentModal(action.ActionID, threadTS)
+						if _, err := api.OpenView(callback.TriggerID, modal); err != nil {
+							resultCh <- &ApprovalResult{Action: action.ActionID, User: callback.User.ID}
+							return
+						}
+					}
+					if !handled {
+						client.Ack(*evt.Request)
+					}
+
+				case slack.InteractionTypeViewSubmission:
+					metadata := strings.SplitN(callback.View.PrivateMetadata, "|", 2)
+					if len(metadata) != 2 || metadata[1] != threadTS {
+						client.Ack(*evt.Request)
+						continue
+					}
+
+					var comment string
+					if block, ok := callback.View.State.Values["comment_block"]; ok {
+						if value, ok := block["comment_input"]; ok {
+							comment = value.Value
+						}
+					}
+
+					client.Ack(*evt.Request)
+					resultCh <- &ApprovalResult{Action: metadata[0], User: callback.User.ID, Comment: comment}
+					return
+
+				default:
+					client.Ack(*evt.Request)
+				}
+			}
+		}
+	}()
+
+	select {
+	case result := <-resultCh:
+		return result, nil
 	case <-ctx.Done():
 		return nil, nil
 	}
 }
 
+func runApprovalWait(client *socketmode.Client, api *slack.Client, channelID, threadTS string, waitTimeout int, cancel context.CancelFunc, emitter eventEmitter) {
+	result, err := waitForInteractionSocketMode(client, api, channelID, threadTS, waitTimeout)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error waiting for approval: %v\n", err)
+		cancel()
+		os.Exit(1)
+	}
+
+	var responseMessage string
+	var approval *approvalInfo
+	status := "timeout"
+	exitCode := exitCodeTimeout
+
+	if result == nil {
+		responseMessage = "⏱️ Timed out waiting for a decision."
+	} else {
+		fromDisplay := result.User
+		if userInfo, err := api.GetUserInfo(result.User); err == nil {
+			if userInfo.Profile.DisplayName != "" {
+				fromDisplay = "@" + userInfo.Profile.DisplayName
+			} else {
+				fromDisplay = "@" + userInfo.Name
+			}
+		}
+
+		approval = &approvalInfo{
+			Action:      result.Action,
+			User:        result.User,
+			DisplayName: fromDisplay,
+			Comment:     result.Comment,
+		}
+
+		switch result.Action {
+		case "approve":
+			exitCode = exitCodeApprove
+			status = "approved"
+			responseMessage = fmt.Sprintf("✅ Approved by %s", fromDisplay)
+		case "reject":
+			exitCode = exitCodeReject
+			status = "rejected"
+			responseMessage = fmt.Sprintf("❌ Rejected by %s", fromDisplay)
+		default:
+			exitCode = exitCodeChanges
+			status = "changes_requested"
+			responseMessage = fmt.Sprintf("🔁 Changes requested by %s", fromDisplay)
+		}
+		if result.Comment != "" {
+			responseMessage += fmt.Sprintf("\n> %s", result.Comment)
+		}
+	}
+
+	emitter.approvalFinish(approval, status)
+
+	responseOptions := []slack.MsgOption{
+		slack.MsgOptionText(responseMessage, false),
+		slack.MsgOptionAsUser(false),
+		slack.MsgOptionTS(threadTS),
+	}
+
+	if _, _, err := api.PostMessage(channelID, responseOptions...); err != nil {
+		fmt.Printf("Warning: Failed to post response status: %v\n", err)
+	}
+
+	cancel()
+	os.Exit(exitCode)
+}
+
 func parseSlackTimestamp(ts string) (time.Time, error) {
 	parts := strings.Split(ts, ".")
 	if len(parts) != 2 {
 		return time.Time{}, fmt.Errorf("invalid timestamp format")
 	}
-	
+
 	sec, err := strconv.ParseInt(parts[0], 10, 64)
 	if err != nil {
 		return time.Time{}, err
 	}
-	
+
 	return time.Unix(sec, 0), nil
 }
 
@@ -425,11 +1221,11 @@ func runSetup(cmd *cobra.Command, args []string) error {
 	if _, err := os.Stat(configPath); err == nil {
 		fmt.Printf("Configuration file already exists at: %s\n", configPath)
 		fmt.Print("Do you want to overwrite it? (y/N): ")
-		
+
 		reader := bufio.NewReader(os.Stdin)
 		answer, _ := reader.ReadString('\n')
 		answer = strings.TrimSpace(strings.ToLower(answer))
-		
+
 		if answer != "y" && answer != "yes" {
 			fmt.Println("Setup cancelled.")
 			return nil
@@ -441,6 +1237,10 @@ func runSetup(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to create config directory: %w", err)
 	}
 
+	if oauthSetup {
+		return runOAuthSetup(configPath)
+	}
+
 	reader := bufio.NewReader(os.Stdin)
 
 	fmt.Print("Enter your Slack Bot Token (xoxb-...): ")
@@ -462,7 +1262,7 @@ func runSetup(cmd *cobra.Command, args []string) error {
 	fmt.Print("Enter default timeout in seconds (default: 300): ")
 	timeoutStr, _ := reader.ReadString('\n')
 	timeoutStr = strings.TrimSpace(timeoutStr)
-	
+
 	defaultTimeout := 300
 	if timeoutStr != "" {
 		if t, err := fmt.Sscanf(timeoutStr, "%d", &defaultTimeout); err != nil || t != 1 {
@@ -490,7 +1290,158 @@ func runSetup(cmd *cobra.Command, args []string) error {
 	fmt.Printf("\nConfiguration saved to: %s\n", configPath)
 	fmt.Println("\nYou can now use ask-human-cli with:")
 	fmt.Println("  ask-human-cli ask --question \"Your question here\" --title \"Question title\"")
-	
+
+	return nil
+}
+
+const oauthStateTTL = 5 * time.Minute
+
+const oauthScopes = "channels:read,groups:read,chat:write,users:read,files:write,reactions:read"
+
+type oauthState struct {
+	value     string
+	expiresAt time.Time
+}
+
+func newOAuthState() (oauthState, error) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return oauthState{}, fmt.Errorf("failed to generate OAuth state: %w", err)
+	}
+	return oauthState{value: hex.EncodeToString(buf), expiresAt: time.Now().Add(oauthStateTTL)}, nil
+}
+
+func (s oauthState) valid(candidate string) bool {
+	return candidate != "" && candidate == s.value && time.Now().Before(s.expiresAt)
+}
+
+type oauthCallbackResult struct {
+	code string
+	err  error
+}
+
+func runOAuthSetup(configPath string) error {
+	clientID := oauthClientID
+	if clientID == "" {
+		clientID = os.Getenv("SLACK_CLIENT_ID")
+	}
+	clientSecret := oauthClientSecret
+	if clientSecret == "" {
+		clientSecret = os.Getenv("SLACK_CLIENT_SECRET")
+	}
+	if clientID == "" || clientSecret == "" {
+		return fmt.Errorf("--client-id/--client-secret or SLACK_CLIENT_ID/SLACK_CLIENT_SECRET are required for --oauth")
+	}
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return fmt.Errorf("failed to start local OAuth listener: %w", err)
+	}
+	defer listener.Close()
+
+	redirectURI := fmt.Sprintf("http://127.0.0.1:%d/callback", listener.Addr().(*net.TCPAddr).Port)
+
+	state, err := newOAuthState()
+	if err != nil {
+		return err
+	}
+
+	resultCh := make(chan oauthCallbackResult, 1)
+	mux := http.NewServeMux()
+	mux.HandleFunc("/callback", func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+
+		if authErr := query.Get("error"); authErr != "" {
+			resultCh <- oauthCallbackResult{err: fmt.Errorf("slack authorization denied: %s", authErr)}
+			fmt.Fprintln(w, "Authorization denied. You can close this tab.")
+			return
+		}
+
+		if !state.valid(query.Get("state")) {
+			resultCh <- oauthCallbackResult{err: fmt.Errorf("received a stale or mismatched OAuth state")}
+			fmt.Fprintln(w, "This authorization link has expired. You can close this tab.")
+			return
+		}
+
+		resultCh <- oauthCallbackResult{code: query.Get("code")}
+		fmt.Fprintln(w, "Authorization complete. You can close this tab and return to the terminal.")
+	})
+
+	server := &http.Server{Handler: mux}
+	go server.Serve(listener)
+	defer server.Close()
+
+	authorizeURL := fmt.Sprintf(
+		"https://slack.com/oauth/v2/authorize?client_id=%s&scope=%s&state=%s&redirect_uri=%s",
+		url.QueryEscape(clientID), url.QueryEscape(oauthScopes), url.QueryEscape(state.value), url.QueryEscape(redirectURI),
+	)
+
+	fmt.Println("Open the following URL in your browser to authorize ask-human-cli:")
+	fmt.Println(authorizeURL)
+	fmt.Println("\nWaiting for authorization...")
+
+	var result oauthCallbackResult
+	select {
+	case result = <-resultCh:
+	case <-time.After(oauthStateTTL):
+		return fmt.Errorf("timed out waiting for OAuth authorization")
+	}
+	if result.err != nil {
+		return result.err
+	}
+
+	oauthResp, err := slack.GetOAuthV2Response(&http.Client{}, clientID, clientSecret, result.code, redirectURI)
+	if err != nil {
+		return fmt.Errorf("failed to exchange OAuth code for a token: %w", err)
+	}
+
+	fmt.Printf("\nAuthorized workspace: %s\n", oauthResp.Team.Name)
+
+	reader := bufio.NewReader(os.Stdin)
+
+	fmt.Print("Enter your Slack App Token (xapp-...): ")
+	appToken, _ := reader.ReadString('\n')
+	appToken = strings.TrimSpace(appToken)
+
+	fmt.Print("Enter default channel (e.g., #general): ")
+	channel, _ := reader.ReadString('\n')
+	channel = strings.TrimSpace(channel)
+	if channel != "" && !strings.HasPrefix(channel, "#") {
+		channel = "#" + channel
+	}
+
+	fmt.Print("Enter default timeout in seconds (default: 300): ")
+	timeoutStr, _ := reader.ReadString('\n')
+	timeoutStr = strings.TrimSpace(timeoutStr)
+
+	defaultTimeout := 300
+	if timeoutStr != "" {
+		if t, err := fmt.Sscanf(timeoutStr, "%d", &defaultTimeout); err != nil || t != 1 {
+			fmt.Println("Invalid timeout, using default 300 seconds")
+			defaultTimeout = 300
+		}
+	}
+
+	config := Config{
+		SlackToken:     oauthResp.AccessToken,
+		AppToken:       appToken,
+		DefaultChannel: channel,
+		DefaultTimeout: defaultTimeout,
+	}
+
+	data, err := json.MarshalIndent(config, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal config: %w", err)
+	}
+
+	if err := os.WriteFile(configPath, data, 0600); err != nil {
+		return fmt.Errorf("failed to write config file: %w", err)
+	}
+
+	fmt.Printf("\nConfiguration saved to: %s\n", configPath)
+	fmt.Println("\nYou can now use ask-human-cli with:")
+	fmt.Println("  ask-human-cli ask --question \"Your question here\" --title \"Question title\"")
+
 	return nil
 }
 
@@ -520,6 +1471,23 @@ Using --thread-ts allows you to:
 - Maintain context across multiple questions
 - Keep related discussions organized in one place
 
+Reacting instead of replying:
+   ask-human-cli ask --question "Ship it?" --title "Release" --require reaction --ack-emoji ok,ship_it --reject-emoji no_entry
+
+With --require reaction (or --require any to accept either signal), a reaction
+on the question message with one of the --ack-emoji or --reject-emoji names
+counts as the response instead of a text reply; other reactions are ignored -
+useful when a human would rather react than type. This requires
+the Slack app's Event Subscriptions to include the reaction_added bot event.
+
+Gating on multiple approvers:
+   ask-human-cli ask --question "Deploy to prod?" --title "Release" --require-approvers 2 --approvers @alice,@bob,@carol
+
+With --require-approvers N, the command waits until N distinct users have
+responded (combine with --approvers to restrict who counts) before
+returning, so automated deploys can require multi-person sign-off instead
+of a single reply.
+
 Perfect for:
 - AI agents needing human input
 - Automated workflows requiring manual approval
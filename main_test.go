@@ -0,0 +1,74 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/slack-go/slack"
+)
+
+func TestApproverRepliesAddDedupesAndOrders(t *testing.T) {
+	replies := newApproverReplies()
+
+	if !replies.add("U1", &slack.Message{Msg: slack.Msg{Text: "first"}}) {
+		t.Fatal("expected first reply from U1 to be recorded")
+	}
+	if replies.add("U1", &slack.Message{Msg: slack.Msg{Text: "second"}}) {
+		t.Fatal("expected duplicate reply from U1 to be rejected")
+	}
+	if !replies.add("U2", &slack.Message{Msg: slack.Msg{Text: "third"}}) {
+		t.Fatal("expected first reply from U2 to be recorded")
+	}
+
+	if got := replies.len(); got != 2 {
+		t.Fatalf("len() = %d, want 2", got)
+	}
+
+	msgs := replies.messages()
+	if len(msgs) != 2 || msgs[0].Text != "first" || msgs[1].Text != "third" {
+		t.Fatalf("messages() = %+v, want [first, third] preserving arrival order", msgs)
+	}
+}
+
+func TestMatchApprover(t *testing.T) {
+	users := []slack.User{
+		{ID: "U1", Name: "alice"},
+		{ID: "U2", Name: "bob", Profile: slack.UserProfile{DisplayName: "Bobby"}},
+	}
+
+	if id, ok := matchApprover(users, "alice"); !ok || id != "U1" {
+		t.Fatalf("matchApprover(alice) = (%q, %v), want (U1, true)", id, ok)
+	}
+	if id, ok := matchApprover(users, "Bobby"); !ok || id != "U2" {
+		t.Fatalf("matchApprover(Bobby) = (%q, %v), want (U2, true)", id, ok)
+	}
+	if _, ok := matchApprover(users, "carol"); ok {
+		t.Fatal("matchApprover(carol) = true, want false")
+	}
+}
+
+func TestRecordApproverReplyQuorum(t *testing.T) {
+	replies := newApproverReplies()
+
+	if recordApproverReply(replies, nil, 2, "U1", &slack.Message{}) {
+		t.Fatal("quorum of 2 should not be reached after 1 reply")
+	}
+	if !recordApproverReply(replies, nil, 2, "U2", &slack.Message{}) {
+		t.Fatal("quorum of 2 should be reached after 2 distinct replies")
+	}
+}
+
+func TestRecordApproverReplyRejectsNonApprover(t *testing.T) {
+	replies := newApproverReplies()
+	allowed := map[string]struct{}{"U1": {}}
+
+	if recordApproverReply(replies, allowed, 1, "U2", &slack.Message{}) {
+		t.Fatal("reply from a non-approver should not count towards quorum")
+	}
+	if replies.len() != 0 {
+		t.Fatalf("len() = %d, want 0 after a non-approver reply", replies.len())
+	}
+
+	if !recordApproverReply(replies, allowed, 1, "U1", &slack.Message{}) {
+		t.Fatal("reply from an allowed approver should reach quorum of 1")
+	}
+}